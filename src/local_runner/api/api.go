@@ -0,0 +1,58 @@
+// Package api - Contrôle HTTP du runner local DATA-MINER
+// Expose la gestion des jobs, l'historique des runs et le streaming de logs
+// pour les dashboards et pipelines CI qui s'intègrent au runner.
+package api
+
+import "time"
+
+// Job - Représentation API d'un job, découplée du type interne du runner
+type Job struct {
+	ID                 string        `json:"id"`
+	Name               string        `json:"name"`
+	Command            string        `json:"command"`
+	Args               []string      `json:"args"`
+	Schedule           string        `json:"schedule"`
+	Timeout            time.Duration `json:"timeout"`
+	CreatedAt          time.Time     `json:"created_at"`
+	Status             string        `json:"status"`
+	FailureThreshold   int           `json:"failure_threshold"`
+	FailureWindow      int           `json:"failure_window"`
+	CooldownAfterPause time.Duration `json:"cooldown_after_pause"`
+	PausedUntil        time.Time     `json:"paused_until"`
+	PauseReason        string        `json:"pause_reason,omitempty"`
+	Executor           string        `json:"executor,omitempty"`
+	Image              string        `json:"image,omitempty"`
+	Mounts             []string      `json:"mounts,omitempty"`
+	Host               string        `json:"host,omitempty"`
+	User               string        `json:"user,omitempty"`
+	Env                []string      `json:"env,omitempty"`
+}
+
+// Run - Représentation API d'un run, découplée du type interne du runner
+type Run struct {
+	JobID      string        `json:"job_id"`
+	RunID      string        `json:"run_id"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+	Duration   time.Duration `json:"duration"`
+	ExitCode   int           `json:"exit_code"`
+	Status     string        `json:"status"`
+	StdoutPath string        `json:"stdout_path"`
+	StderrPath string        `json:"stderr_path"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// Runner - Ensemble d'opérations que le runner local doit exposer à l'API
+// Permet de tester le serveur HTTP avec un faux runner sans dépendre de LocalRunner
+type Runner interface {
+	ListJobs() []*Job
+	GetJob(id string) (*Job, bool)
+	UpsertJob(job *Job) error
+	DeleteJob(id string) error
+	TriggerJob(id string) (runID string, err error)
+	PauseJob(id, reason string) error
+	ResumeJob(id string) error
+	ListRuns(jobID string, limit int) ([]*Run, error)
+	GetRun(runID string) (*Run, error)
+	TailLog(runID string, offset int64) ([]byte, int64, error)
+}
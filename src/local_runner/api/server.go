@@ -0,0 +1,244 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound - Ressource demandée introuvable
+var ErrNotFound = errors.New("ressource introuvable")
+
+// Server - Serveur HTTP de contrôle du runner (jobs, runs, logs)
+type Server struct {
+	runner Runner
+	token  string
+	mux    *http.ServeMux
+}
+
+// NewServer - Construit le serveur de contrôle, protégé par un bearer token
+// Un token vide désactive l'authentification (usage local/dev uniquement)
+func NewServer(runner Runner, token string) *Server {
+	s := &Server{runner: runner, token: token, mux: http.NewServeMux()}
+
+	s.mux.HandleFunc("/jobs", s.handleJobs)
+	s.mux.HandleFunc("/jobs/", s.handleJobByID)
+	s.mux.HandleFunc("/runs/", s.handleRunByID)
+
+	return s
+}
+
+// ListenAndServe - Démarre le serveur HTTP sur l'adresse donnée
+func (s *Server) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, s.withAuth(s.mux))
+}
+
+// withAuth - Vérifie le bearer token sur chaque requête si un token est configuré
+func (s *Server) withAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if s.token == "" {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		header := req.Header.Get("Authorization")
+		provided := strings.TrimPrefix(header, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(s.token)) != 1 {
+			http.Error(w, "non autorisé", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// handleJobs - GET /jobs (liste), POST /jobs (création/mise à jour)
+func (s *Server) handleJobs(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.runner.ListJobs())
+	case http.MethodPost:
+		var job Job
+		if err := json.NewDecoder(req.Body).Decode(&job); err != nil {
+			http.Error(w, fmt.Sprintf("corps de requête invalide: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := s.runner.UpsertJob(&job); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, http.StatusOK, &job)
+	default:
+		http.Error(w, "méthode non supportée", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleJobByID - GET/DELETE /jobs/{id}, GET /jobs/{id}/runs, POST /jobs/{id}/trigger
+func (s *Server) handleJobByID(w http.ResponseWriter, req *http.Request) {
+	rest := strings.TrimPrefix(req.URL.Path, "/jobs/")
+	parts := strings.SplitN(rest, "/", 2)
+	jobID := parts[0]
+	if jobID == "" {
+		http.NotFound(w, req)
+		return
+	}
+
+	if len(parts) == 2 {
+		switch {
+		case parts[1] == "runs" && req.Method == http.MethodGet:
+			s.handleListRuns(w, req, jobID)
+			return
+		case parts[1] == "trigger" && req.Method == http.MethodPost:
+			s.handleTrigger(w, req, jobID)
+			return
+		case parts[1] == "pause" && req.Method == http.MethodPost:
+			s.handlePause(w, req, jobID)
+			return
+		case parts[1] == "resume" && req.Method == http.MethodPost:
+			s.handleResume(w, req, jobID)
+			return
+		}
+		http.NotFound(w, req)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		job, ok := s.runner.GetJob(jobID)
+		if !ok {
+			http.Error(w, ErrNotFound.Error(), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	case http.MethodDelete:
+		if err := s.runner.DeleteJob(jobID); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "méthode non supportée", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleListRuns - GET /jobs/{id}/runs?limit=N
+func (s *Server) handleListRuns(w http.ResponseWriter, req *http.Request, jobID string) {
+	limit := 0
+	if raw := req.URL.Query().Get("limit"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil {
+			limit = parsed
+		}
+	}
+
+	runs, err := s.runner.ListRuns(jobID, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, runs)
+}
+
+// handleTrigger - POST /jobs/{id}/trigger déclenche immédiatement une exécution
+func (s *Server) handleTrigger(w http.ResponseWriter, req *http.Request, jobID string) {
+	runID, err := s.runner.TriggerJob(jobID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]string{"run_id": runID})
+}
+
+// handlePause - POST /jobs/{id}/pause, corps optionnel {"reason": "..."}
+func (s *Server) handlePause(w http.ResponseWriter, req *http.Request, jobID string) {
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	json.NewDecoder(req.Body).Decode(&body)
+	if body.Reason == "" {
+		body.Reason = "pause manuelle via API"
+	}
+
+	if err := s.runner.PauseJob(jobID, body.Reason); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleResume - POST /jobs/{id}/resume
+func (s *Server) handleResume(w http.ResponseWriter, req *http.Request, jobID string) {
+	if err := s.runner.ResumeJob(jobID); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRunByID - GET /runs/{id}, GET /runs/{id}/log?follow=1
+func (s *Server) handleRunByID(w http.ResponseWriter, req *http.Request) {
+	rest := strings.TrimPrefix(req.URL.Path, "/runs/")
+	parts := strings.SplitN(rest, "/", 2)
+	runID := parts[0]
+	if runID == "" {
+		http.NotFound(w, req)
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "log" {
+		s.handleLog(w, req, runID)
+		return
+	}
+
+	run, err := s.runner.GetRun(runID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, run)
+}
+
+// handleLog - Diffuse le stdout d'un run via server-sent events quand follow=1, sinon un seul snapshot
+func (s *Server) handleLog(w http.ResponseWriter, req *http.Request, runID string) {
+	follow := req.URL.Query().Get("follow") == "1"
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	flusher, canFlush := w.(http.Flusher)
+	var offset int64
+
+	for {
+		chunk, newOffset, err := s.runner.TailLog(runID, offset)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if len(chunk) > 0 {
+			fmt.Fprintf(w, "data: %s\n\n", strings.ReplaceAll(string(chunk), "\n", "\\n"))
+			if canFlush {
+				flusher.Flush()
+			}
+			offset = newOffset
+		}
+
+		if !follow {
+			return
+		}
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeRunner - Implémentation en mémoire de Runner pour tester le routage HTTP sans LocalRunner
+type fakeRunner struct {
+	jobs map[string]*Job
+	runs map[string]*Run
+
+	triggerRunID string
+	triggerErr   error
+	pauseReason  string
+	pauseErr     error
+	resumeErr    error
+}
+
+func newFakeRunner() *fakeRunner {
+	return &fakeRunner{jobs: make(map[string]*Job), runs: make(map[string]*Run)}
+}
+
+func (f *fakeRunner) ListJobs() []*Job {
+	jobs := make([]*Job, 0, len(f.jobs))
+	for _, job := range f.jobs {
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+func (f *fakeRunner) GetJob(id string) (*Job, bool) {
+	job, ok := f.jobs[id]
+	return job, ok
+}
+
+func (f *fakeRunner) UpsertJob(job *Job) error {
+	f.jobs[job.ID] = job
+	return nil
+}
+
+func (f *fakeRunner) DeleteJob(id string) error {
+	if _, ok := f.jobs[id]; !ok {
+		return ErrNotFound
+	}
+	delete(f.jobs, id)
+	return nil
+}
+
+func (f *fakeRunner) TriggerJob(id string) (string, error) {
+	if f.triggerErr != nil {
+		return "", f.triggerErr
+	}
+	return f.triggerRunID, nil
+}
+
+func (f *fakeRunner) PauseJob(id, reason string) error {
+	f.pauseReason = reason
+	return f.pauseErr
+}
+
+func (f *fakeRunner) ResumeJob(id string) error {
+	return f.resumeErr
+}
+
+func (f *fakeRunner) ListRuns(jobID string, limit int) ([]*Run, error) {
+	return nil, nil
+}
+
+func (f *fakeRunner) GetRun(runID string) (*Run, error) {
+	run, ok := f.runs[runID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return run, nil
+}
+
+func (f *fakeRunner) TailLog(runID string, offset int64) ([]byte, int64, error) {
+	return nil, offset, ErrNotFound
+}
+
+func TestServerRejectsMissingOrWrongToken(t *testing.T) {
+	server := NewServer(newFakeRunner(), "secret")
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	rec := httptest.NewRecorder()
+	server.withAuth(server.mux).ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("sans token: status = %d, attendu %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	req.Header.Set("Authorization", "Bearer mauvais-token")
+	rec = httptest.NewRecorder()
+	server.withAuth(server.mux).ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("mauvais token: status = %d, attendu %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestServerAcceptsCorrectToken(t *testing.T) {
+	server := NewServer(newFakeRunner(), "secret")
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	server.withAuth(server.mux).ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("bon token: status = %d, attendu %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestServerNoTokenConfiguredDisablesAuth(t *testing.T) {
+	server := NewServer(newFakeRunner(), "")
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	rec := httptest.NewRecorder()
+	server.withAuth(server.mux).ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("sans auth configurée: status = %d, attendu %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestServerTriggerJobRouting(t *testing.T) {
+	runner := newFakeRunner()
+	runner.jobs["job1"] = &Job{ID: "job1"}
+	runner.triggerRunID = "run-42"
+	server := NewServer(runner, "")
+
+	req := httptest.NewRequest(http.MethodPost, "/jobs/job1/trigger", nil)
+	rec := httptest.NewRecorder()
+	server.mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, attendu %d", rec.Code, http.StatusAccepted)
+	}
+	var body map[string]string
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("décodage réponse: %v", err)
+	}
+	if body["run_id"] != "run-42" {
+		t.Errorf("run_id = %q, attendu %q", body["run_id"], "run-42")
+	}
+}
+
+func TestServerTriggerUnknownJobReturnsNotFound(t *testing.T) {
+	runner := newFakeRunner()
+	runner.triggerErr = ErrNotFound
+	server := NewServer(runner, "")
+
+	req := httptest.NewRequest(http.MethodPost, "/jobs/missing/trigger", nil)
+	rec := httptest.NewRecorder()
+	server.mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, attendu %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestServerPauseAndResumeRouting(t *testing.T) {
+	runner := newFakeRunner()
+	runner.jobs["job1"] = &Job{ID: "job1"}
+	server := NewServer(runner, "")
+
+	req := httptest.NewRequest(http.MethodPost, "/jobs/job1/pause", strings.NewReader(`{"reason":"maintenance"}`))
+	rec := httptest.NewRecorder()
+	server.mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("pause: status = %d, attendu %d", rec.Code, http.StatusNoContent)
+	}
+	if runner.pauseReason != "maintenance" {
+		t.Errorf("pause reason = %q, attendu %q", runner.pauseReason, "maintenance")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/jobs/job1/resume", nil)
+	rec = httptest.NewRecorder()
+	server.mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("resume: status = %d, attendu %d", rec.Code, http.StatusNoContent)
+	}
+}
+
+func TestServerGetRunLogNonStreaming(t *testing.T) {
+	runner := newFakeRunner()
+	server := NewServer(runner, "")
+
+	req := httptest.NewRequest(http.MethodGet, "/runs/run1/log", nil)
+	rec := httptest.NewRecorder()
+	server.mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, attendu %d (aucun run ni log)", rec.Code, http.StatusNotFound)
+	}
+}
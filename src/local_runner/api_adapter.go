@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gerivdb/DATA-MINER/src/local_runner/api"
+	"github.com/google/uuid"
+)
+
+// ListJobs - Implémente api.Runner: snapshot de tous les jobs connus
+func (r *LocalRunner) ListJobs() []*api.Job {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	jobs := make([]*api.Job, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		jobs = append(jobs, toAPIJob(job))
+	}
+	return jobs
+}
+
+// GetJob - Implémente api.Runner: recherche d'un job par ID
+func (r *LocalRunner) GetJob(id string) (*api.Job, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, false
+	}
+	return toAPIJob(job), true
+}
+
+// UpsertJob - Implémente api.Runner: enregistre ou remplace un job et sa planification cron
+func (r *LocalRunner) UpsertJob(apiJob *api.Job) error {
+	if apiJob.ID == "" {
+		return fmt.Errorf("job invalide: id requis")
+	}
+	if apiJob.Timeout <= 0 {
+		apiJob.Timeout = defaultJobTimeout
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if entryID, exists := r.jobEntries[apiJob.ID]; exists {
+		r.cron.Remove(entryID)
+		delete(r.jobEntries, apiJob.ID)
+	}
+
+	job := fromAPIJob(apiJob)
+	return r.addJobLocked(job)
+}
+
+// DeleteJob - Implémente api.Runner: retire un job et sa planification cron
+func (r *LocalRunner) DeleteJob(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.jobs[id]; !ok {
+		return api.ErrNotFound
+	}
+
+	if entryID, exists := r.jobEntries[id]; exists {
+		r.cron.Remove(entryID)
+		delete(r.jobEntries, id)
+	}
+	delete(r.jobs, id)
+	r.updateJobGaugesLocked()
+	return nil
+}
+
+// TriggerJob - Implémente api.Runner: déclenche immédiatement une exécution hors planification
+func (r *LocalRunner) TriggerJob(id string) (string, error) {
+	r.mu.RLock()
+	job, ok := r.jobs[id]
+	r.mu.RUnlock()
+	if !ok {
+		return "", api.ErrNotFound
+	}
+
+	runID := uuid.NewString()
+	go r.executeJobWithRunID(job, runID)
+	return runID, nil
+}
+
+func toAPIJob(job *Job) *api.Job {
+	return &api.Job{
+		ID:                 job.ID,
+		Name:               job.Name,
+		Command:            job.Command,
+		Args:               job.Args,
+		Schedule:           job.Schedule,
+		Timeout:            job.Timeout,
+		CreatedAt:          job.CreatedAt,
+		Status:             job.Status,
+		FailureThreshold:   job.FailureThreshold,
+		FailureWindow:      job.FailureWindow,
+		CooldownAfterPause: job.CooldownAfterPause,
+		PausedUntil:        job.PausedUntil,
+		PauseReason:        job.PauseReason,
+		Executor:           job.Executor,
+		Image:              job.Image,
+		Mounts:             job.Mounts,
+		Host:               job.Host,
+		User:               job.User,
+		Env:                job.Env,
+	}
+}
+
+func fromAPIJob(job *api.Job) *Job {
+	return &Job{
+		ID:                 job.ID,
+		Name:               job.Name,
+		Command:            job.Command,
+		Args:               job.Args,
+		Schedule:           job.Schedule,
+		Timeout:            job.Timeout,
+		CreatedAt:          job.CreatedAt,
+		Status:             job.Status,
+		FailureThreshold:   job.FailureThreshold,
+		FailureWindow:      job.FailureWindow,
+		CooldownAfterPause: job.CooldownAfterPause,
+		PausedUntil:        job.PausedUntil,
+		PauseReason:        job.PauseReason,
+		Executor:           job.Executor,
+		Image:              job.Image,
+		Mounts:             job.Mounts,
+		Host:               job.Host,
+		User:               job.User,
+		Env:                job.Env,
+	}
+}
+
+func toAPIRun(run *Run) *api.Run {
+	return &api.Run{
+		JobID:      run.JobID,
+		RunID:      run.RunID,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		Duration:   run.Duration,
+		ExitCode:   run.ExitCode,
+		Status:     run.Status,
+		StdoutPath: run.StdoutPath,
+		StderrPath: run.StderrPath,
+		Error:      run.Error,
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// skipIfPaused - Si le job est en pause, l'ignore (en levant la pause d'elle-même si le cooldown est écoulé)
+func (r *LocalRunner) skipIfPaused(job *Job) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if job.Status != "paused" {
+		return false
+	}
+
+	if !job.PausedUntil.IsZero() && time.Now().After(job.PausedUntil) {
+		r.resumeJobLocked(job, "cooldown écoulé")
+		return false
+	}
+
+	r.logger.Printf("job=%s event=skip_paused reason=%q", job.ID, job.PauseReason)
+	return true
+}
+
+// recordOutcomeAndMaybePause - Met à jour la fenêtre glissante d'un job et le met en pause si le seuil d'échec est dépassé
+func (r *LocalRunner) recordOutcomeAndMaybePause(job *Job, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	threshold := job.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultFailureThreshold
+	}
+	window := job.FailureWindow
+	if window <= 0 {
+		window = defaultFailureWindow
+	}
+
+	history := append(r.failureHistory[job.ID], failed)
+	if len(history) > window {
+		history = history[len(history)-window:]
+	}
+	r.failureHistory[job.ID] = history
+
+	if reason, shouldPause := shouldPause(history, threshold, window); shouldPause {
+		r.pauseJobLocked(job, reason)
+	}
+}
+
+// shouldPause - Applique les deux règles de backoff: échecs consécutifs ou taux d'échec sur la fenêtre
+func shouldPause(history []bool, threshold, window int) (string, bool) {
+	consecutive := 0
+	for i := len(history) - 1; i >= 0; i-- {
+		if !history[i] {
+			break
+		}
+		consecutive++
+	}
+	if consecutive >= threshold {
+		return fmt.Sprintf("%d échecs consécutifs", consecutive), true
+	}
+
+	if len(history) >= window {
+		failures := 0
+		for _, f := range history {
+			if f {
+				failures++
+			}
+		}
+		rate := float64(failures) / float64(len(history))
+		if rate > defaultFailureRate {
+			return fmt.Sprintf("taux d'échec %.0f%% sur %d runs", rate*100, len(history)), true
+		}
+	}
+
+	return "", false
+}
+
+// pauseJobLocked - Met un job en pause, mu doit déjà être détenu en écriture
+func (r *LocalRunner) pauseJobLocked(job *Job, reason string) {
+	job.Status = "paused"
+	job.PauseReason = reason
+	if job.CooldownAfterPause > 0 {
+		job.PausedUntil = time.Now().Add(job.CooldownAfterPause)
+	} else {
+		job.PausedUntil = time.Time{}
+	}
+	r.logger.Printf("job=%s event=paused reason=%q cooldown=%s", job.ID, reason, job.CooldownAfterPause)
+	r.saveJobStateLocked(job)
+	r.updateJobGaugesLocked()
+}
+
+// resumeJobLocked - Lève la pause d'un job et réinitialise sa fenêtre d'échecs, mu doit déjà être détenu en écriture
+func (r *LocalRunner) resumeJobLocked(job *Job, reason string) {
+	job.Status = "active"
+	job.PauseReason = ""
+	job.PausedUntil = time.Time{}
+	delete(r.failureHistory, job.ID)
+	r.logger.Printf("job=%s event=resumed reason=%q", job.ID, reason)
+	r.saveJobStateLocked(job)
+	r.updateJobGaugesLocked()
+}
+
+// saveJobStateLocked - Persiste l'état de pause courant du job, mu doit déjà être détenu en écriture
+// Sans cette persistance un redémarrage du runner oublierait qu'un job était en pause (voir JobStore.SaveJobState)
+func (r *LocalRunner) saveJobStateLocked(job *Job) {
+	state := &JobState{JobID: job.ID, Status: job.Status, PauseReason: job.PauseReason, PausedUntil: job.PausedUntil}
+	if err := r.store.SaveJobState(state); err != nil {
+		r.logger.Printf("ERREUR sauvegarde état job %s: %v", job.ID, err)
+	}
+}
+
+// PauseJob - Met un job en pause manuellement, en sautant ses prochaines invocations planifiées
+func (r *LocalRunner) PauseJob(id, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return fmt.Errorf("job %s introuvable", id)
+	}
+	r.pauseJobLocked(job, reason)
+	return nil
+}
+
+// ResumeJob - Reprend un job précédemment mis en pause
+func (r *LocalRunner) ResumeJob(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return fmt.Errorf("job %s introuvable", id)
+	}
+	r.resumeJobLocked(job, "reprise manuelle")
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestShouldPauseConsecutiveFailures(t *testing.T) {
+	history := []bool{false, true, true, true}
+	reason, pause := shouldPause(history, 3, 10)
+	if !pause {
+		t.Fatalf("shouldPause() = false, attendu true (3 échecs consécutifs)")
+	}
+	if reason == "" {
+		t.Errorf("shouldPause() reason vide")
+	}
+}
+
+func TestShouldPauseFailureRateUsesConfiguredWindow(t *testing.T) {
+	// Fenêtre réduite à 3: le taux d'échec (2/3 > defaultFailureRate) doit déclencher la pause
+	// même si l'historique est plus court que defaultFailureWindow.
+	history := []bool{true, false, true}
+	_, pause := shouldPause(history, 10, 3)
+	if !pause {
+		t.Fatalf("shouldPause() = false, attendu true (taux d'échec sur la fenêtre configurée)")
+	}
+}
+
+func TestShouldPauseBelowConfiguredWindowDoesNotEvaluateRate(t *testing.T) {
+	// Historique trop court pour la fenêtre configurée: la règle de taux ne doit pas s'appliquer.
+	history := []bool{true, false}
+	_, pause := shouldPause(history, 10, 5)
+	if pause {
+		t.Fatalf("shouldPause() = true, attendu false (historique sous la fenêtre configurée)")
+	}
+}
+
+func TestShouldPauseNoFailuresNeverPauses(t *testing.T) {
+	history := []bool{false, false, false}
+	_, pause := shouldPause(history, 3, 3)
+	if pause {
+		t.Fatalf("shouldPause() = true, attendu false (aucun échec)")
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CoordinatorConfig - Sélection et paramétrage du backend de coordination distribuée
+type CoordinatorConfig struct {
+	// Backend - "file", "redis" ou "etcd". Vide ou "none" = pas de coordination (exécution toujours locale)
+	Backend string `json:"backend"`
+	// RunnerGroup - Identifiant du groupe de runners partageant la même planification
+	RunnerGroup string `json:"runner_group"`
+	// LockDir - Répertoire des fichiers de verrou du backend "file"; DOIT être un volume partagé (NFS, SMB, etc.)
+	// visible par tous les runners du groupe. Vide = "./.coordinator/<RunnerGroup>" (CWD, usage mono-hôte/dev uniquement)
+	LockDir string `json:"lock_dir"`
+
+	RedisAddr     string   `json:"redis_addr"`
+	EtcdEndpoints []string `json:"etcd_endpoints"`
+}
+
+// Lease - Bail détenu sur une clé, le gagnant d'un tick de cron
+type Lease interface {
+	// Renew prolonge le bail; une erreur signifie que le bail est probablement perdu
+	Renew(ctx context.Context, ttl time.Duration) error
+	// Release libère le bail avant son expiration naturelle
+	Release(ctx context.Context) error
+}
+
+// Coordinator - Élection de leader entre plusieurs instances de LocalRunner partageant un RunnerGroup
+// Permet à plusieurs process runner de tourner en HA sans dupliquer les exécutions de jobs
+type Coordinator interface {
+	// AcquireLease tente d'obtenir le bail exclusif sur key pour une durée ttl
+	// ok=false signifie qu'un autre runner détient déjà le bail (ce tick est perdu)
+	AcquireLease(ctx context.Context, key string, ttl time.Duration) (lease Lease, ok bool, err error)
+	// Close libère les ressources sous-jacentes du coordinateur (connexions, verrous ouverts)
+	Close() error
+}
+
+// singleNodeCoordinator - Coordinateur par défaut quand aucun backend n'est configuré: ce runner gagne toujours
+type singleNodeCoordinator struct{}
+
+func (singleNodeCoordinator) AcquireLease(ctx context.Context, key string, ttl time.Duration) (Lease, bool, error) {
+	return noopLease{}, true, nil
+}
+func (singleNodeCoordinator) Close() error { return nil }
+
+// noopLease - Bail factice pour le coordinateur mono-nœud
+type noopLease struct{}
+
+func (noopLease) Renew(ctx context.Context, ttl time.Duration) error { return nil }
+func (noopLease) Release(ctx context.Context) error                  { return nil }
+
+// NewCoordinator - Construit le coordinateur configuré
+func NewCoordinator(config CoordinatorConfig) (Coordinator, error) {
+	switch config.Backend {
+	case "", "none":
+		return singleNodeCoordinator{}, nil
+	case "file":
+		return NewFileLockCoordinator(config)
+	case "redis":
+		return NewRedisCoordinator(config)
+	case "etcd":
+		return NewEtcdCoordinator(config)
+	default:
+		return nil, fmt.Errorf("backend coordinateur inconnu: %s", config.Backend)
+	}
+}
+
+// leaseKey - Clé de bail pour un tick donné, au format runner/<group>/<job_id>/<fire_time>
+func leaseKey(group, jobID string, fireTime time.Time) string {
+	return fmt.Sprintf("runner/%s/%s/%d", group, jobID, fireTime.Unix())
+}
+
+// coordinatorLeaseSlack - Marge ajoutée au timeout du job pour le TTL du bail, pour survivre à un heartbeat manqué
+const coordinatorLeaseSlack = time.Minute
+
+// startLeaseHeartbeat - Renouvelle périodiquement le bail tant que le job tourne, pour permettre une prise de
+// relais par un autre runner si ce process meurt en plein job (le bail expire alors naturellement après ttl)
+func (r *LocalRunner) startLeaseHeartbeat(lease Lease, ttl time.Duration) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := lease.Renew(context.Background(), ttl); err != nil {
+					r.logger.Printf("ERREUR renouvellement bail: %v", err)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
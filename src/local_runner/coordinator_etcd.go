@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdCoordinator - Coordinateur basé sur les baux etcd, pour les déploiements partageant déjà un cluster etcd
+type EtcdCoordinator struct {
+	client *clientv3.Client
+}
+
+// NewEtcdCoordinator - Construit le coordinateur etcd
+func NewEtcdCoordinator(config CoordinatorConfig) (*EtcdCoordinator, error) {
+	if len(config.EtcdEndpoints) == 0 {
+		return nil, fmt.Errorf("coordinateur etcd: etcd_endpoints requis")
+	}
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   config.EtcdEndpoints,
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erreur connexion etcd: %w", err)
+	}
+	return &EtcdCoordinator{client: client}, nil
+}
+
+// AcquireLease - Bail etcd natif (lease + transaction conditionnelle sur la clé)
+func (c *EtcdCoordinator) AcquireLease(ctx context.Context, key string, ttl time.Duration) (Lease, bool, error) {
+	etcdLease, err := c.client.Grant(ctx, int64(ttl.Seconds()))
+	if err != nil {
+		return nil, false, fmt.Errorf("erreur création bail etcd: %w", err)
+	}
+
+	txn := c.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, "held", clientv3.WithLease(etcdLease.ID))).
+		Else(clientv3.OpGet(key))
+
+	resp, err := txn.Commit()
+	if err != nil {
+		return nil, false, fmt.Errorf("erreur acquisition bail etcd %s: %w", key, err)
+	}
+	if !resp.Succeeded {
+		return nil, false, nil
+	}
+
+	return &etcdLeaseHandle{client: c.client, leaseID: etcdLease.ID}, true, nil
+}
+
+// Close - Ferme la connexion etcd
+func (c *EtcdCoordinator) Close() error {
+	return c.client.Close()
+}
+
+// etcdLeaseHandle - Bail détenu via EtcdCoordinator
+type etcdLeaseHandle struct {
+	client  *clientv3.Client
+	leaseID clientv3.LeaseID
+}
+
+// Renew - Demande un keep-alive ponctuel sur le bail etcd
+func (l *etcdLeaseHandle) Renew(ctx context.Context, ttl time.Duration) error {
+	_, err := l.client.KeepAliveOnce(ctx, l.leaseID)
+	if err != nil {
+		return fmt.Errorf("erreur renouvellement bail etcd: %w", err)
+	}
+	return nil
+}
+
+// Release - Révoque le bail, supprimant la clé associée
+func (l *etcdLeaseHandle) Release(ctx context.Context) error {
+	_, err := l.client.Revoke(ctx, l.leaseID)
+	if err != nil {
+		return fmt.Errorf("erreur révocation bail etcd: %w", err)
+	}
+	return nil
+}
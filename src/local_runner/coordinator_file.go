@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileLockCoordinator - Coordinateur de secours basé sur des fichiers de verrou partagés (ex: volume réseau monté par tous les runners)
+// Chaque clé de bail correspond à un fichier <LockDir>/<key>.lock (les "/" de la clé remplacés par "_") contenant le détenteur et son expiration
+type FileLockCoordinator struct {
+	mu      sync.Mutex
+	lockDir string
+	holder  string
+}
+
+// NewFileLockCoordinator - Construit le coordinateur fichier
+// config.LockDir doit pointer vers un volume monté par tous les runners du groupe pour que la coordination
+// soit réellement inter-process; à défaut (vide), on retombe sur le CWD, qui ne coordonne qu'au sein d'un même hôte
+func NewFileLockCoordinator(config CoordinatorConfig) (*FileLockCoordinator, error) {
+	lockDir := config.LockDir
+	if lockDir == "" {
+		lockDir = filepath.Join(".", ".coordinator", config.RunnerGroup)
+	}
+	if err := os.MkdirAll(lockDir, 0755); err != nil {
+		return nil, fmt.Errorf("erreur création répertoire verrous: %w", err)
+	}
+
+	hostname, _ := os.Hostname()
+	return &FileLockCoordinator{
+		lockDir: lockDir,
+		holder:  fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}, nil
+}
+
+type fileLeaseRecord struct {
+	Holder    string    `json:"holder"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (c *FileLockCoordinator) lockPath(key string) string {
+	return filepath.Join(c.lockDir, strings.ReplaceAll(key, "/", "_")+".lock")
+}
+
+// AcquireLease - Crée le fichier de verrou si absent ou expiré; échec silencieux si un autre runner le détient déjà
+// c.mu ne protège que les accès concurrents au sein de ce process: l'exclusion mutuelle entre runners distincts
+// repose sur la création atomique du fichier (O_CREATE|O_EXCL), pas sur ce verrou en mémoire
+func (c *FileLockCoordinator) AcquireLease(ctx context.Context, key string, ttl time.Duration) (Lease, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	path := c.lockPath(key)
+	record := fileLeaseRecord{Holder: c.holder, ExpiresAt: time.Now().Add(ttl)}
+
+	if createLeaseFileExclusive(path, record) {
+		return &fileLease{coordinator: c, key: key}, true, nil
+	}
+
+	// Le fichier existe déjà: soit un autre runner détient un bail valide, soit il est périmé (détenteur mort)
+	existing, err := readLeaseRecord(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("erreur lecture verrou %s: %w", path, err)
+	}
+	if time.Now().Before(existing.ExpiresAt) && existing.Holder != c.holder {
+		return nil, false, nil // un autre runner détient un bail encore valide
+	}
+
+	// Bail périmé ou déjà à nous: prise de relais (takeover). On retente une création atomique après
+	// suppression du fichier périmé; une course reste possible entre deux runners qui détectent la péremption
+	// en même temps, mais elle est bornée au seul cas takeover
+	if existing.Holder != c.holder {
+		os.Remove(path)
+		if !createLeaseFileExclusive(path, record) {
+			return nil, false, nil // un autre runner a gagné la course au takeover
+		}
+		return &fileLease{coordinator: c, key: key}, true, nil
+	}
+
+	if err := writeLeaseRecord(path, record); err != nil {
+		return nil, false, fmt.Errorf("erreur écriture verrou %s: %w", path, err)
+	}
+	return &fileLease{coordinator: c, key: key}, true, nil
+}
+
+// createLeaseFileExclusive - Crée le fichier de verrou de façon atomique (échoue si le fichier existe déjà),
+// seule garantie d'exclusion mutuelle valable entre process distincts sur un même volume
+func createLeaseFileExclusive(path string, record fileLeaseRecord) bool {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return false
+	}
+	_, err = file.Write(data)
+	return err == nil
+}
+
+// Close - Rien à libérer, les fichiers de verrou expirent naturellement
+func (c *FileLockCoordinator) Close() error {
+	return nil
+}
+
+func readLeaseRecord(path string) (*fileLeaseRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var record fileLeaseRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func writeLeaseRecord(path string, record fileLeaseRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// fileLease - Bail détenu via FileLockCoordinator
+type fileLease struct {
+	coordinator *FileLockCoordinator
+	key         string
+}
+
+// Renew - Repousse l'expiration du fichier de verrou
+func (l *fileLease) Renew(ctx context.Context, ttl time.Duration) error {
+	l.coordinator.mu.Lock()
+	defer l.coordinator.mu.Unlock()
+
+	path := l.coordinator.lockPath(l.key)
+	existing, err := readLeaseRecord(path)
+	if err != nil {
+		return fmt.Errorf("bail %s introuvable, probablement perdu: %w", l.key, err)
+	}
+	if existing.Holder != l.coordinator.holder {
+		return fmt.Errorf("bail %s repris par %s", l.key, existing.Holder)
+	}
+
+	return writeLeaseRecord(path, fileLeaseRecord{Holder: l.coordinator.holder, ExpiresAt: time.Now().Add(ttl)})
+}
+
+// Release - Supprime le fichier de verrou avant expiration naturelle
+func (l *fileLease) Release(ctx context.Context) error {
+	l.coordinator.mu.Lock()
+	defer l.coordinator.mu.Unlock()
+	err := os.Remove(l.coordinator.lockPath(l.key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
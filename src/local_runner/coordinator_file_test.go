@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileLockCoordinatorMutualExclusion(t *testing.T) {
+	dir := t.TempDir()
+	config := CoordinatorConfig{RunnerGroup: "test", LockDir: dir}
+
+	c1, err := NewFileLockCoordinator(config)
+	if err != nil {
+		t.Fatalf("erreur création coordinateur 1: %v", err)
+	}
+	c2, err := NewFileLockCoordinator(config)
+	if err != nil {
+		t.Fatalf("erreur création coordinateur 2: %v", err)
+	}
+	c1.holder = "runner-1"
+	c2.holder = "runner-2"
+
+	ctx := context.Background()
+	key := "runner/test/job-a/1"
+
+	_, won1, err := c1.AcquireLease(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatalf("erreur acquisition bail 1: %v", err)
+	}
+	if !won1 {
+		t.Fatalf("le premier runner aurait dû obtenir le bail")
+	}
+
+	_, won2, err := c2.AcquireLease(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatalf("erreur acquisition bail 2: %v", err)
+	}
+	if won2 {
+		t.Fatalf("le second runner ne devrait pas obtenir un bail déjà détenu")
+	}
+}
+
+func TestFileLockCoordinatorTakeoverAfterExpiry(t *testing.T) {
+	dir := t.TempDir()
+	config := CoordinatorConfig{RunnerGroup: "test", LockDir: dir}
+
+	c1, _ := NewFileLockCoordinator(config)
+	c2, _ := NewFileLockCoordinator(config)
+	c1.holder = "runner-1"
+	c2.holder = "runner-2"
+
+	ctx := context.Background()
+	key := "runner/test/job-a/1"
+
+	if _, won, err := c1.AcquireLease(ctx, key, time.Millisecond); err != nil || !won {
+		t.Fatalf("acquisition initiale attendue: won=%v err=%v", won, err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	_, won, err := c2.AcquireLease(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatalf("erreur takeover: %v", err)
+	}
+	if !won {
+		t.Fatalf("un bail périmé devrait pouvoir être repris par un autre runner")
+	}
+}
+
+func TestFileLockCoordinatorLockPathUsesConfiguredDir(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewFileLockCoordinator(CoordinatorConfig{RunnerGroup: "test", LockDir: dir})
+	if err != nil {
+		t.Fatalf("erreur création coordinateur: %v", err)
+	}
+
+	got := c.lockPath("runner/test/job-a/1")
+	want := filepath.Join(dir, "runner_test_job-a_1.lock")
+	if got != want {
+		t.Fatalf("lockPath = %q, attendu %q", got, want)
+	}
+}
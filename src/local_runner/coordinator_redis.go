@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCoordinator - Coordinateur basé sur des baux Redis (SET NX PX), adapté aux déploiements multi-hôtes
+type RedisCoordinator struct {
+	client *redis.Client
+}
+
+// NewRedisCoordinator - Construit le coordinateur Redis
+func NewRedisCoordinator(config CoordinatorConfig) (*RedisCoordinator, error) {
+	if config.RedisAddr == "" {
+		return nil, fmt.Errorf("coordinateur redis: redis_addr requis")
+	}
+	client := redis.NewClient(&redis.Options{Addr: config.RedisAddr})
+	return &RedisCoordinator{client: client}, nil
+}
+
+// AcquireLease - SET NX avec expiration, valeur = jeton unique du détenteur
+func (c *RedisCoordinator) AcquireLease(ctx context.Context, key string, ttl time.Duration) (Lease, bool, error) {
+	token := uuid.NewString()
+	ok, err := c.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("erreur acquisition bail redis %s: %w", key, err)
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	return &redisLease{client: c.client, key: key, token: token}, true, nil
+}
+
+// Close - Ferme la connexion Redis
+func (c *RedisCoordinator) Close() error {
+	return c.client.Close()
+}
+
+// redisLease - Bail détenu via RedisCoordinator, identifié par un jeton pour éviter de renouveler/libérer le bail d'un autre
+type redisLease struct {
+	client *redis.Client
+	key    string
+	token  string
+}
+
+// renewScript - Ne prolonge le TTL que si nous sommes toujours le détenteur légitime
+const renewScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+else
+	return 0
+end
+`
+
+// releaseScript - Ne supprime la clé que si nous sommes toujours le détenteur légitime
+const releaseScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+else
+	return 0
+end
+`
+
+func (l *redisLease) Renew(ctx context.Context, ttl time.Duration) error {
+	res, err := l.client.Eval(ctx, renewScript, []string{l.key}, l.token, ttl.Milliseconds()).Result()
+	if err != nil {
+		return fmt.Errorf("erreur renouvellement bail redis %s: %w", l.key, err)
+	}
+	if n, _ := res.(int64); n == 0 {
+		return fmt.Errorf("bail redis %s repris par un autre détenteur", l.key)
+	}
+	return nil
+}
+
+func (l *redisLease) Release(ctx context.Context) error {
+	_, err := l.client.Eval(ctx, releaseScript, []string{l.key}, l.token).Result()
+	if err != nil {
+		return fmt.Errorf("erreur libération bail redis %s: %w", l.key, err)
+	}
+	return nil
+}
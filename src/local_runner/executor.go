@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// ExecOptions - Environnement d'exécution fourni à un Executor par executeJob
+type ExecOptions struct {
+	WorkspacePath string
+	// Env - Environnement complet de l'hôte runner (os.Environ() + variables DATA-MINER), pour l'executor
+	// qui tourne sur l'hôte lui-même (local) où il n'y a pas de frontière d'isolation à préserver.
+	// L'executor ssh ne l'utilise pas: aucune variable n'est transmise à l'hôte distant
+	Env []string
+	// DataMinerEnv - Uniquement les variables DATA-MINER (pas l'environnement hôte), pour les executors isolés
+	// (Docker) où forwarder Env leaquerait tout le process hôte dans une image tierce
+	DataMinerEnv []string
+	Stdout       io.Writer
+	Stderr       io.Writer
+}
+
+// ExecResult - Résultat d'une exécution, indépendant du backend qui l'a produite
+type ExecResult struct {
+	ExitCode int
+}
+
+// Executor - Backend exécutant un Job, découplant executeJob du process local
+// Permet d'isoler les jobs (Docker) ou de les dispatcher sur un hôte distant (SSH) sans changer la planification
+type Executor interface {
+	Execute(ctx context.Context, job *Job, opts ExecOptions) (ExecResult, error)
+}
+
+// executorFor - Sélectionne l'Executor du job, "local" par défaut
+func (r *LocalRunner) executorFor(job *Job) (Executor, error) {
+	name := job.Executor
+	if name == "" {
+		name = "local"
+	}
+
+	executor, ok := r.executors[name]
+	if !ok {
+		return nil, fmt.Errorf("executor inconnu: %s", name)
+	}
+	return executor, nil
+}
+
+// defaultExecutors - Construit le registre des executors disponibles pour ce runner
+func defaultExecutors(config *GoRunnerConfig) map[string]Executor {
+	return map[string]Executor{
+		"local":  &LocalExecutor{},
+		"docker": &DockerExecutor{},
+		"ssh":    NewSSHExecutor(config.SSHPool),
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// DockerExecutor - Exécute le job à l'intérieur d'une image Docker, avec le workspace monté et les variables injectées
+// Offre l'isolation que le LocalExecutor ne peut pas fournir (dépendances, permissions, système de fichiers jetable)
+type DockerExecutor struct{}
+
+// Execute - Lance `docker run --rm` avec le workspace monté, les montages additionnels du job et son environnement
+func (e *DockerExecutor) Execute(ctx context.Context, job *Job, opts ExecOptions) (ExecResult, error) {
+	if job.Image == "" {
+		return ExecResult{}, fmt.Errorf("executor docker: job %s n'a pas d'image configurée", job.ID)
+	}
+
+	cmd := exec.CommandContext(ctx, "docker", dockerRunArgs(job, opts)...)
+	cmd.Stdout = opts.Stdout
+	cmd.Stderr = opts.Stderr
+
+	err := cmd.Run()
+	return ExecResult{ExitCode: cmd.ProcessState.ExitCode()}, err
+}
+
+// dockerRunArgs - Construit les arguments de `docker run`. N'injecte jamais l'environnement complet de l'hôte
+// (opts.Env): seules les variables DATA-MINER et celles que le job autorise explicitement via Env traversent
+// la frontière d'isolation
+func dockerRunArgs(job *Job, opts ExecOptions) []string {
+	args := []string{"run", "--rm", "-v", fmt.Sprintf("%s:/workspace", opts.WorkspacePath), "-w", "/workspace"}
+	for _, mount := range job.Mounts {
+		args = append(args, "-v", mount)
+	}
+	for _, env := range opts.DataMinerEnv {
+		args = append(args, "-e", env)
+	}
+	for _, env := range job.Env {
+		args = append(args, "-e", env)
+	}
+	args = append(args, job.Image, job.Command)
+	args = append(args, job.Args...)
+	return args
+}
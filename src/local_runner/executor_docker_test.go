@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestDockerRunArgsNeverForwardsHostEnv(t *testing.T) {
+	job := &Job{ID: "j1", Image: "alpine", Command: "echo", Env: []string{"JOB_SECRET=abc"}}
+	opts := ExecOptions{
+		WorkspacePath: "/ws",
+		Env:           []string{"HOST_SECRET=leak", "PATH=/usr/bin"},
+		DataMinerEnv:  []string{"DATA_MINER_RUNNER_ID=r1"},
+	}
+
+	args := dockerRunArgs(job, opts)
+
+	for _, want := range []string{"DATA_MINER_RUNNER_ID=r1", "JOB_SECRET=abc"} {
+		if !containsEnvArg(args, want) {
+			t.Errorf("dockerRunArgs() manque -e %q, args=%v", want, args)
+		}
+	}
+	for _, unwanted := range opts.Env {
+		if containsEnvArg(args, unwanted) {
+			t.Errorf("dockerRunArgs() a transmis une variable hôte interdite -e %q, args=%v", unwanted, args)
+		}
+	}
+}
+
+func containsEnvArg(args []string, value string) bool {
+	for i, arg := range args {
+		if arg == "-e" && i+1 < len(args) && args[i+1] == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+)
+
+// LocalExecutor - Comportement historique: exécution directe du process sur l'hôte du runner
+type LocalExecutor struct{}
+
+// Execute - Lance la commande du job via exec.CommandContext dans le workspace du runner
+func (e *LocalExecutor) Execute(ctx context.Context, job *Job, opts ExecOptions) (ExecResult, error) {
+	cmd := exec.CommandContext(ctx, job.Command, job.Args...)
+	cmd.Dir = opts.WorkspacePath
+	cmd.Env = opts.Env
+	cmd.Stdout = opts.Stdout
+	cmd.Stderr = opts.Stderr
+
+	err := cmd.Run()
+	return ExecResult{ExitCode: cmd.ProcessState.ExitCode()}, err
+}
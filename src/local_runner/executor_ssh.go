@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+)
+
+// SSHExecutor - Dispatche le job sur un hôte distant via `ssh`, tiré d'un pool configuré quand le job n'en impose pas un
+type SSHExecutor struct {
+	pool []string
+	next uint64
+}
+
+// NewSSHExecutor - Construit l'executor SSH avec son pool d'hôtes de repli
+func NewSSHExecutor(pool []string) *SSHExecutor {
+	return &SSHExecutor{pool: pool}
+}
+
+// Execute - Construit la commande distante et l'exécute via `ssh user@host command args...`
+func (e *SSHExecutor) Execute(ctx context.Context, job *Job, opts ExecOptions) (ExecResult, error) {
+	host := job.Host
+	if host == "" {
+		var err error
+		host, err = e.pickHost()
+		if err != nil {
+			return ExecResult{}, fmt.Errorf("executor ssh: job %s: %w", job.ID, err)
+		}
+	}
+
+	target := host
+	if job.User != "" {
+		target = fmt.Sprintf("%s@%s", job.User, host)
+	}
+
+	remoteCmd := shellJoin(append([]string{job.Command}, job.Args...))
+	cmd := exec.CommandContext(ctx, "ssh", target, remoteCmd)
+	cmd.Stdout = opts.Stdout
+	cmd.Stderr = opts.Stderr
+
+	err := cmd.Run()
+	return ExecResult{ExitCode: cmd.ProcessState.ExitCode()}, err
+}
+
+// pickHost - Répartit les jobs sans hôte explicite sur le pool configuré, par rotation simple
+func (e *SSHExecutor) pickHost() (string, error) {
+	if len(e.pool) == 0 {
+		return "", fmt.Errorf("aucun hôte SSH configuré (job.Host vide et pool vide)")
+	}
+	index := atomic.AddUint64(&e.next, 1) - 1
+	return e.pool[index%uint64(len(e.pool))], nil
+}
+
+// shellJoin - Assemble les arguments en une commande shell distante sûre, chaque argument étant quoté
+// individuellement pour empêcher la ré-interprétation par le shell de l'hôte distant (espaces, métacaractères)
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// shellQuote - Quote un argument au format shell POSIX (guillemets simples, échappement des guillemets simples internes)
+func shellQuote(arg string) string {
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
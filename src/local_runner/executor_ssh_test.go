@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestShellQuoteEscapesMetacharacters(t *testing.T) {
+	cases := map[string]string{
+		"plain":       "'plain'",
+		"has space":   "'has space'",
+		"it's quoted": `'it'\''s quoted'`,
+		"$(rm -rf /)": `'$(rm -rf /)'`,
+		"a;b":         "'a;b'",
+	}
+
+	for input, want := range cases {
+		if got := shellQuote(input); got != want {
+			t.Errorf("shellQuote(%q) = %q, attendu %q", input, got, want)
+		}
+	}
+}
+
+func TestShellJoinQuotesEachArgument(t *testing.T) {
+	got := shellJoin([]string{"echo", "hello world", "a;rm -rf /"})
+	want := "'echo' 'hello world' 'a;rm -rf /'"
+	if got != want {
+		t.Fatalf("shellJoin = %q, attendu %q", got, want)
+	}
+}
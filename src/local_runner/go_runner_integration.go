@@ -9,43 +9,114 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
+
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/gerivdb/DATA-MINER/src/local_runner/api"
+	"github.com/gerivdb/DATA-MINER/src/local_runner/metrics"
 )
 
 // GoRunnerConfig - Configuration runner local DATA-MINER
 type GoRunnerConfig struct {
-	RunnerID       string `json:"runner_id"`
-	WorkspacePath  string `json:"workspace_path"`
-	LogPath        string `json:"log_path"`
-	Schedule       string `json:"schedule"` // Cron format
-	GitHubToken    string `json:"github_token"`
+	RunnerID       string   `json:"runner_id"`
+	WorkspacePath  string   `json:"workspace_path"`
+	LogPath        string   `json:"log_path"`
+	Schedule       string   `json:"schedule"` // Cron format
+	GitHubToken    string   `json:"github_token"`
 	EcosystemRepos []string `json:"ecosystem_repos"`
-	ParallelJobs   int    `json:"parallel_jobs"`
+	ParallelJobs   int      `json:"parallel_jobs"`
+	StoreDriver    string   `json:"store_driver"` // "sqlite" (défaut) ou "file"
+	APIAddr        string   `json:"api_addr"`     // ex: ":8090", vide désactive l'API
+	APIToken       string   `json:"api_token"`    // bearer token requis par l'API, vide = pas d'auth
+
+	// Coordinator - Backend de coordination pour partager une planification entre plusieurs instances de runner
+	Coordinator CoordinatorConfig `json:"coordinator"`
+
+	// SSHPool - Hôtes utilisés par l'executor SSH pour les jobs qui ne fixent pas Job.Host explicitement
+	SSHPool []string `json:"ssh_pool"`
+
+	// MetricsAddr - Adresse d'écoute du endpoint /metrics Prometheus, vide désactive l'exposition
+	MetricsAddr string `json:"metrics_addr"`
+	// Telemetry - Paramétrage OpenTelemetry (export OTLP, taux d'échantillonnage)
+	Telemetry metrics.TelemetryConfig `json:"telemetry"`
 }
 
 // Job - Définition job mining local
 type Job struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Command   string    `json:"command"`
-	Args      []string  `json:"args"`
-	Schedule  string    `json:"schedule"`
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Command  string   `json:"command"`
+	Args     []string `json:"args"`
+	Schedule string   `json:"schedule"`
+	// Timeout - Durée maximale d'une exécution avant annulation du contexte (0 = valeur par défaut, voir defaultJobTimeout)
 	Timeout   time.Duration `json:"timeout"`
-	CreatedAt time.Time `json:"created_at"`
-	Status    string    `json:"status"`
+	CreatedAt time.Time     `json:"created_at"`
+	Status    string        `json:"status"`
+
+	// FailureThreshold - Nombre d'échecs consécutifs déclenchant une pause automatique (0 = valeur par défaut)
+	FailureThreshold int `json:"failure_threshold"`
+	// FailureWindow - Taille de la fenêtre glissante de runs sur laquelle le taux d'échec est évalué (0 = valeur par défaut)
+	FailureWindow int `json:"failure_window"`
+	// CooldownAfterPause - Durée avant qu'une pause automatique ne soit levée d'elle-même (0 = pause jusqu'à reprise manuelle)
+	CooldownAfterPause time.Duration `json:"cooldown_after_pause"`
+	// PausedUntil - Horodatage de fin de la pause automatique en cours, zéro si le job n'est pas en pause
+	PausedUntil time.Time `json:"paused_until"`
+	// PauseReason - Raison de la dernière mise en pause, pour diagnostic
+	PauseReason string `json:"pause_reason,omitempty"`
+
+	// Executor - Backend d'exécution: "local" (défaut), "docker" ou "ssh"
+	Executor string `json:"executor,omitempty"`
+	// Image - Image Docker utilisée quand Executor == "docker"
+	Image string `json:"image,omitempty"`
+	// Mounts - Montages "host:container" passés au conteneur quand Executor == "docker"
+	Mounts []string `json:"mounts,omitempty"`
+	// Host - Hôte distant utilisé quand Executor == "ssh" (vide = tiré du pool configuré)
+	Host string `json:"host,omitempty"`
+	// User - Utilisateur SSH utilisé quand Executor == "ssh"
+	User string `json:"user,omitempty"`
+	// Env - Variables "KEY=VALUE" supplémentaires explicitement autorisées à être forwardées à un executor isolé
+	// (Docker); ne provient jamais de l'environnement hôte, uniquement de ce que le job déclare lui-même
+	Env []string `json:"env,omitempty"`
 }
 
+// Valeurs par défaut du mécanisme de backoff automatique quand un job ne les configure pas explicitement
+const (
+	defaultFailureThreshold = 5  // échecs consécutifs
+	defaultFailureWindow    = 20 // runs glissants
+	defaultFailureRate      = 0.5
+)
+
+// defaultJobTimeout - Timeout appliqué à un job qui n'en configure pas un (Timeout <= 0): sans ce filet, un
+// Timeout à zéro annule le contexte d'exécution avant même le démarrage du process ("context deadline exceeded")
+const defaultJobTimeout = 15 * time.Minute
+
 // LocalRunner - Runner local DATA-MINER échappant aux tokens payants
 type LocalRunner struct {
-	config *GoRunnerConfig
-	cron   *cron.Cron
-	jobs   map[string]*Job
-	logger *log.Logger
+	mu          sync.RWMutex
+	config      *GoRunnerConfig
+	cron        *cron.Cron
+	jobs        map[string]*Job
+	jobEntries  map[string]cron.EntryID
+	logger      *log.Logger
+	store       JobStore
+	coordinator Coordinator
+	executors   map[string]Executor
+
+	metrics        *metrics.Metrics
+	tracer         oteltrace.Tracer
+	tracerShutdown func(context.Context) error
+
+	// failureHistory - Fenêtre glissante des derniers résultats par job (true = échec), pour le backoff automatique
+	failureHistory map[string][]bool
 }
 
 // NewLocalRunner - Constructeur runner local
@@ -60,17 +131,40 @@ func NewLocalRunner(configPath string) (*LocalRunner, error) {
 	os.MkdirAll(config.LogPath, 0755)
 
 	// Logger
-	logFile, err := os.OpenFile(filepath.Join(config.LogPath, "runner.log"), 
+	logFile, err := os.OpenFile(filepath.Join(config.LogPath, "runner.log"),
 		os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
 		return nil, fmt.Errorf("erreur création log: %w", err)
 	}
 
+	store, err := NewJobStore(config)
+	if err != nil {
+		return nil, fmt.Errorf("erreur initialisation job store: %w", err)
+	}
+
+	coordinator, err := NewCoordinator(config.Coordinator)
+	if err != nil {
+		return nil, fmt.Errorf("erreur initialisation coordinateur: %w", err)
+	}
+
+	tracerProvider, tracerShutdown, err := metrics.NewTracerProvider(config.Telemetry)
+	if err != nil {
+		return nil, fmt.Errorf("erreur initialisation traçage: %w", err)
+	}
+
 	return &LocalRunner{
-		config: config,
-		cron:   cron.New(),
-		jobs:   make(map[string]*Job),
-		logger: log.New(logFile, "[DATA-MINER-RUNNER] ", log.LstdFlags),
+		config:         config,
+		cron:           cron.New(),
+		jobs:           make(map[string]*Job),
+		jobEntries:     make(map[string]cron.EntryID),
+		logger:         log.New(logFile, "[DATA-MINER-RUNNER] ", log.LstdFlags),
+		store:          store,
+		coordinator:    coordinator,
+		executors:      defaultExecutors(config),
+		metrics:        metrics.NewMetrics(),
+		tracer:         tracerProvider.Tracer("datamine-local-runner"),
+		tracerShutdown: tracerShutdown,
+		failureHistory: make(map[string][]bool),
 	}, nil
 }
 
@@ -81,10 +175,35 @@ func (r *LocalRunner) Start() error {
 	// Jobs DATA-MINER standards
 	r.addDataMinerJobs()
 
+	// Ré-applique les pauses automatiques persistées avant le redémarrage (voir backoff.go)
+	r.restorePausedJobs()
+
 	// Démarrage cron
 	r.cron.Start()
 
 	r.logger.Printf("Runner actif avec %d jobs planifiés", len(r.jobs))
+
+	if r.config.APIAddr != "" {
+		server := api.NewServer(r, r.config.APIToken)
+		go func() {
+			r.logger.Printf("API de contrôle à l'écoute sur %s", r.config.APIAddr)
+			if err := server.ListenAndServe(r.config.APIAddr); err != nil {
+				r.logger.Printf("ERREUR API: %v", err)
+			}
+		}()
+	}
+
+	if r.config.MetricsAddr != "" {
+		go func() {
+			r.logger.Printf("Métriques Prometheus à l'écoute sur %s/metrics", r.config.MetricsAddr)
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", r.metrics.Handler())
+			if err := http.ListenAndServe(r.config.MetricsAddr, mux); err != nil {
+				r.logger.Printf("ERREUR serveur métriques: %v", err)
+			}
+		}()
+	}
+
 	return nil
 }
 
@@ -92,124 +211,295 @@ func (r *LocalRunner) Start() error {
 func (r *LocalRunner) addDataMinerJobs() {
 	// Job 1: Mining écosystème hebdomadaire
 	ecosystemJob := &Job{
-		ID:      "ecosystem-mining-weekly",
-		Name:    "Mining ECOSYSTEM-1 hebdomadaire",
-		Command: "python3",
-		Args:    []string{"scripts/ecosystem_mining.py", "--comprehensive"},
+		ID:       "ecosystem-mining-weekly",
+		Name:     "Mining ECOSYSTEM-1 hebdomadaire",
+		Command:  "python3",
+		Args:     []string{"scripts/ecosystem_mining.py", "--comprehensive"},
 		Schedule: "0 2 * * 1", // Lundi 2h du matin
-		Timeout: 30 * time.Minute,
-		Status:  "active",
+		Timeout:  30 * time.Minute,
+		Status:   "active",
 	}
 	r.addJob(ecosystemJob)
 
 	// Job 2: Génération rapport governance quotidien
 	governanceJob := &Job{
-		ID:      "governance-report-daily",
-		Name:    "Rapport governance quotidien",
-		Command: "python3",
-		Args:    []string{"src/governance/ci_gatekeeper.py", "--report"},
+		ID:       "governance-report-daily",
+		Name:     "Rapport governance quotidien",
+		Command:  "python3",
+		Args:     []string{"src/governance/ci_gatekeeper.py", "--report"},
 		Schedule: "0 8 * * *", // Tous les jours 8h
-		Timeout: 10 * time.Minute,
-		Status:  "active",
+		Timeout:  10 * time.Minute,
+		Status:   "active",
 	}
 	r.addJob(governanceJob)
 
 	// Job 3: Nettoyage artefacts anciens
 	cleanupJob := &Job{
-		ID:      "cleanup-old-artifacts",
-		Name:    "Nettoyage artefacts > 30 jours",
-		Command: "powershell",
-		Args:    []string{"-File", "scripts/cleanup-artifacts.ps1", "-Days", "30"},
+		ID:       "cleanup-old-artifacts",
+		Name:     "Nettoyage artefacts > 30 jours",
+		Command:  "powershell",
+		Args:     []string{"-File", "scripts/cleanup-artifacts.ps1", "-Days", "30"},
 		Schedule: "0 1 1 * *", // Premier du mois 1h
-		Timeout: 15 * time.Minute,
-		Status:  "active",
+		Timeout:  15 * time.Minute,
+		Status:   "active",
 	}
 	r.addJob(cleanupJob)
 
 	// Job 4: Synchronisation avec DevTools Hub
 	syncJob := &Job{
-		ID:      "sync-devtools-hub",
-		Name:    "Sync avec DevTools Hub",
-		Command: "make",
-		Args:    []string{"sync-devtools"},
+		ID:       "sync-devtools-hub",
+		Name:     "Sync avec DevTools Hub",
+		Command:  "make",
+		Args:     []string{"sync-devtools"},
 		Schedule: "*/30 * * * *", // Toutes les 30 minutes
-		Timeout: 5 * time.Minute,
-		Status:  "active",
+		Timeout:  5 * time.Minute,
+		Status:   "active",
 	}
 	r.addJob(syncJob)
 }
 
+// restorePausedJobs - Recharge les états de pause persistés et les réapplique aux jobs connus
+// Un job auto-pausé puis jamais repris garde sa pause au redémarrage, au lieu de repartir actif avec une
+// fenêtre d'échecs vierge
+func (r *LocalRunner) restorePausedJobs() {
+	states, err := r.store.LoadJobStates()
+	if err != nil {
+		r.logger.Printf("ERREUR chargement états jobs persistés: %v", err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for jobID, state := range states {
+		job, ok := r.jobs[jobID]
+		if !ok || state.Status != "paused" {
+			continue
+		}
+		job.Status = state.Status
+		job.PauseReason = state.PauseReason
+		job.PausedUntil = state.PausedUntil
+		r.logger.Printf("job=%s event=pause_restored reason=%q", job.ID, job.PauseReason)
+	}
+	r.updateJobGaugesLocked()
+}
+
 // addJob - Ajout job avec planification cron
-func (r *LocalRunner) addJob(job *Job) {
+func (r *LocalRunner) addJob(job *Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.addJobLocked(job)
+}
+
+// addJobLocked - Ajout job avec planification cron, mu doit déjà être détenu en écriture
+// Retourne l'erreur de planification cron le cas échéant, le job reste tout de même enregistré dans r.jobs
+// (consultable/modifiable via l'API) mais sans exécution planifiée tant que Schedule n'est pas corrigé
+func (r *LocalRunner) addJobLocked(job *Job) error {
 	r.jobs[job.ID] = job
-	
+
+	var schedErr error
 	// Planification cron si schedule défini
 	if job.Schedule != "" {
-		r.cron.AddFunc(job.Schedule, func() {
+		entryID, err := r.cron.AddFunc(job.Schedule, func() {
 			r.executeJob(job)
 		})
+		if err != nil {
+			r.logger.Printf("ERREUR planification job %s: %v", job.ID, err)
+			schedErr = fmt.Errorf("planification job %s: %w", job.ID, err)
+		} else {
+			r.jobEntries[job.ID] = entryID
+		}
 	}
+
+	r.updateJobGaugesLocked()
+	return schedErr
 }
 
-// executeJob - Exécution job avec timeout et logging
-func (r *LocalRunner) executeJob(job *Job) {
-	r.logger.Printf("Démarrage job: %s (%s)", job.Name, job.ID)
+// updateJobGaugesLocked - Recalcule les jauges datamine_jobs_active/datamine_jobs_paused, mu doit déjà être détenu
+func (r *LocalRunner) updateJobGaugesLocked() {
+	active, paused := 0, 0
+	for _, job := range r.jobs {
+		if job.Status == "paused" {
+			paused++
+		} else {
+			active++
+		}
+	}
+	r.metrics.SetActiveJobs(active)
+	r.metrics.SetPausedJobs(paused)
+}
+
+// executeJob - Exécution job planifiée par cron, avec un run ID généré à la volée
+func (r *LocalRunner) executeJob(job *Job) string {
+	return r.executeJobWithRunID(job, uuid.NewString())
+}
+
+// executeJobWithRunID - Exécution job avec timeout, streaming des logs et enregistrement du run, sous un run ID
+// fourni par l'appelant. TriggerJob en a besoin pour retourner le run ID au client avant même que le run démarre
+func (r *LocalRunner) executeJobWithRunID(job *Job, runID string) string {
+	if r.skipIfPaused(job) {
+		return runID
+	}
+
+	leaseCtx := context.Background()
+	fireTime := time.Now().Truncate(time.Minute)
+	leaseTTL := job.Timeout + coordinatorLeaseSlack
+	key := leaseKey(r.config.Coordinator.RunnerGroup, job.ID, fireTime)
+
+	lease, won, err := r.coordinator.AcquireLease(leaseCtx, key, leaseTTL)
+	if err != nil {
+		r.logger.Printf("ERREUR coordination job %s: %v", job.ID, err)
+		return runID
+	}
+	if !won {
+		r.logger.Printf("job=%s event=lease_lost tick=%s", job.ID, fireTime.Format(time.RFC3339))
+		return runID
+	}
+	stopHeartbeat := r.startLeaseHeartbeat(lease, leaseTTL)
+	defer stopHeartbeat()
+	defer lease.Release(leaseCtx)
+
+	r.logger.Printf("Démarrage job: %s (%s) run=%s", job.Name, job.ID, runID)
 	start := time.Now()
 
-	// Context avec timeout
-	ctx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+	stdoutPath, stderrPath, stdout, stderr, err := r.openRunLogFiles(job.ID, runID)
+	if err != nil {
+		r.logger.Printf("ERREUR ouverture logs run %s: %v", runID, err)
+		return runID
+	}
+	defer stdout.Close()
+	defer stderr.Close()
+
+	run := &Run{
+		JobID:      job.ID,
+		RunID:      runID,
+		StartedAt:  start,
+		StdoutPath: stdoutPath,
+		StderrPath: stderrPath,
+		Status:     "running",
+	}
+	r.saveRun(run)
+
+	// Context avec timeout, la racine du span OTel propageant jusqu'à l'executor
+	spanCtx, span := r.tracer.Start(context.Background(), "datamine.execute_job",
+		oteltrace.WithAttributes(metrics.JobSpanAttributes(job.ID, job.Command)...))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(spanCtx, job.Timeout)
 	defer cancel()
 
-	// Préparation commande
-	cmd := exec.CommandContext(ctx, job.Command, job.Args...)
-	cmd.Dir = r.config.WorkspacePath
+	executor, err := r.executorFor(job)
+	if err != nil {
+		r.logger.Printf("ERREUR sélection executor job %s: %v", job.ID, err)
+		return runID
+	}
 
-	// Variables environnement
-	cmd.Env = append(os.Environ(), 
+	// Variables DATA-MINER, seules celles-ci sont sûres à transmettre à un executor isolé comme Docker
+	dataMinerEnv := []string{
 		fmt.Sprintf("GITHUB_TOKEN=%s", r.config.GitHubToken),
 		fmt.Sprintf("DATA_MINER_RUNNER_ID=%s", r.config.RunnerID),
 		fmt.Sprintf("DATA_MINER_WORKSPACE=%s", r.config.WorkspacePath),
-	)
-
-	// Exécution
-	output, err := cmd.CombinedOutput()
+	}
+	// Environnement complet, réservé à l'executor qui tourne sur l'hôte (local); l'executor ssh ne l'utilise pas
+	env := append(os.Environ(), dataMinerEnv...)
+
+	// Exécution, déléguée au backend (local, Docker ou SSH) configuré pour ce job
+	result, runErr := executor.Execute(ctx, job, ExecOptions{
+		WorkspacePath: r.config.WorkspacePath,
+		Env:           env,
+		DataMinerEnv:  dataMinerEnv,
+		Stdout:        stdout,
+		Stderr:        stderr,
+	})
 	duration := time.Since(start)
 
-	if err != nil {
-		r.logger.Printf("ERREUR job %s: %v (durée: %v)", job.ID, err, duration)
-		r.logger.Printf("Output: %s", string(output))
-		job.Status = "failed"
+	run.FinishedAt = time.Now()
+	run.Duration = duration
+	run.ExitCode = result.ExitCode
+
+	span.SetAttributes(attribute.Int("datamine.exit_code", result.ExitCode), attribute.Float64("datamine.duration_seconds", duration.Seconds()))
+
+	status := "completed"
+	if runErr != nil {
+		r.logger.Printf("ERREUR job %s: %v (durée: %v)", job.ID, runErr, duration)
+		status = "failed"
+		run.Error = runErr.Error()
+		span.RecordError(runErr)
 	} else {
 		r.logger.Printf("SUCCÈS job %s (durée: %v)", job.ID, duration)
-		job.Status = "completed"
 	}
+	r.setJobStatus(job, status)
+	run.Status = status
+
+	r.saveRun(run)
+	r.metrics.RecordRun(job.ID, status, duration.Seconds(), float64(run.FinishedAt.Unix()))
+	r.recordOutcomeAndMaybePause(job, runErr != nil)
+	return runID
+}
 
-	// Sauvegarde résultats
-	r.saveJobResult(job, string(output), duration, err)
+// setJobStatus - Met à jour le statut d'un job sous r.mu, seul point d'écriture de Job.Status en dehors
+// de pauseJobLocked/resumeJobLocked et de restorePausedJobs: executeJob tourne en goroutine (cron ou TriggerJob) concurremment aux
+// lectures de ListJobs/GetJob, donc l'écriture directe de job.Status serait une data race
+func (r *LocalRunner) setJobStatus(job *Job, status string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job.Status = status
 }
 
-// saveJobResult - Sauvegarde résultats job pour dashboard
-func (r *LocalRunner) saveJobResult(job *Job, output string, duration time.Duration, err error) {
-	result := map[string]interface{}{
-		"job_id":       job.ID,
-		"job_name":     job.Name,
-		"executed_at":  time.Now().Format(time.RFC3339),
-		"duration_ms":  duration.Milliseconds(),
-		"status":       job.Status,
-		"output":       output,
-		"error":        nil,
+// openRunLogFiles - Crée les fichiers stdout/stderr d'un run sous LogPath/runs/<job_id>/<run_id>.{out,err}
+func (r *LocalRunner) openRunLogFiles(jobID, runID string) (stdoutPath, stderrPath string, stdout, stderr *os.File, err error) {
+	runDir := filepath.Join(r.config.LogPath, "runs", jobID)
+	if err = os.MkdirAll(runDir, 0755); err != nil {
+		return "", "", nil, nil, fmt.Errorf("erreur création répertoire run: %w", err)
 	}
 
+	stdoutPath = filepath.Join(runDir, runID+".out")
+	stderrPath = filepath.Join(runDir, runID+".err")
+
+	stdout, err = os.Create(stdoutPath)
+	if err != nil {
+		return "", "", nil, nil, fmt.Errorf("erreur création %s: %w", stdoutPath, err)
+	}
+	stderr, err = os.Create(stderrPath)
 	if err != nil {
-		result["error"] = err.Error()
+		stdout.Close()
+		return "", "", nil, nil, fmt.Errorf("erreur création %s: %w", stderrPath, err)
+	}
+	return stdoutPath, stderrPath, stdout, stderr, nil
+}
+
+// saveRun - Persiste l'état courant du run dans le job store, en journalisant les erreurs
+func (r *LocalRunner) saveRun(run *Run) {
+	if err := r.store.SaveRun(run); err != nil {
+		r.logger.Printf("ERREUR sauvegarde run %s: %v", run.RunID, err)
 	}
+}
 
-	// Sauvegarde JSON horodaté
-	timestamp := time.Now().Format("20060102_150405")
-	resultFile := filepath.Join(r.config.LogPath, fmt.Sprintf("job_%s_%s.json", job.ID, timestamp))
+// ListRuns - Implémente api.Runner: historique des runs d'un job, du plus récent au plus ancien
+func (r *LocalRunner) ListRuns(jobID string, limit int) ([]*api.Run, error) {
+	runs, err := r.store.ListRuns(jobID, limit)
+	if err != nil {
+		return nil, err
+	}
 
-	data, _ := json.MarshalIndent(result, "", "  ")
-	os.WriteFile(resultFile, data, 0644)
+	apiRuns := make([]*api.Run, 0, len(runs))
+	for _, run := range runs {
+		apiRuns = append(apiRuns, toAPIRun(run))
+	}
+	return apiRuns, nil
+}
+
+// GetRun - Implémente api.Runner: détail d'un run par son identifiant
+func (r *LocalRunner) GetRun(runID string) (*api.Run, error) {
+	run, err := r.store.GetRun(runID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIRun(run), nil
+}
+
+// TailLog - Contenu du stdout d'un run depuis un offset donné
+func (r *LocalRunner) TailLog(runID string, offset int64) ([]byte, int64, error) {
+	return r.store.TailLog(runID, offset)
 }
 
 // loadConfig - Chargement configuration depuis JSON
@@ -242,4 +532,4 @@ func main() {
 
 	// Maintenir le runner actif
 	select {}
-}
\ No newline at end of file
+}
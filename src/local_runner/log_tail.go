@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// tailLogFile - Lit un fichier de log à partir d'offset et retourne les octets lus ainsi que le nouvel offset
+// Commun aux implémentations SQLite et fichier du JobStore, qui stockent toutes deux les logs sur disque
+func tailLogFile(path string, offset int64) ([]byte, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, offset, fmt.Errorf("erreur ouverture log %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, fmt.Errorf("erreur positionnement log %s: %w", path, err)
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, offset, fmt.Errorf("erreur lecture log %s: %w", path, err)
+	}
+
+	return data, offset + int64(len(data)), nil
+}
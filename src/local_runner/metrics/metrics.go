@@ -0,0 +1,76 @@
+// Package metrics - Exposition Prometheus de l'exécution des jobs DATA-MINER
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics - Jeu de métriques Prometheus du runner, à instancier une fois et partager entre les jobs
+type Metrics struct {
+	registry             *prometheus.Registry
+	runsTotal            *prometheus.CounterVec
+	durationSeconds      *prometheus.HistogramVec
+	lastSuccessTimestamp *prometheus.GaugeVec
+	jobsActive           prometheus.Gauge
+	jobsPaused           prometheus.Gauge
+}
+
+// NewMetrics - Crée et enregistre les métriques du runner dans un registre dédié
+func NewMetrics() *Metrics {
+	registry := prometheus.NewRegistry()
+
+	m := &Metrics{
+		registry: registry,
+		runsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "datamine_job_runs_total",
+			Help: "Nombre total d'exécutions de jobs, par job et par statut final",
+		}, []string{"job", "status"}),
+		durationSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "datamine_job_duration_seconds",
+			Help:    "Durée des exécutions de jobs, en secondes",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"job"}),
+		lastSuccessTimestamp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "datamine_job_last_success_timestamp",
+			Help: "Horodatage Unix de la dernière exécution réussie d'un job",
+		}, []string{"job"}),
+		jobsActive: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "datamine_jobs_active",
+			Help: "Nombre de jobs actuellement actifs (ni en pause ni supprimés)",
+		}),
+		jobsPaused: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "datamine_jobs_paused",
+			Help: "Nombre de jobs actuellement en pause (automatique ou manuelle)",
+		}),
+	}
+
+	registry.MustRegister(m.runsTotal, m.durationSeconds, m.lastSuccessTimestamp, m.jobsActive, m.jobsPaused)
+	return m
+}
+
+// RecordRun - Enregistre le résultat et la durée d'une exécution terminée
+func (m *Metrics) RecordRun(jobID, status string, durationSeconds float64, successTimestamp float64) {
+	m.runsTotal.WithLabelValues(jobID, status).Inc()
+	m.durationSeconds.WithLabelValues(jobID).Observe(durationSeconds)
+	if status == "completed" {
+		m.lastSuccessTimestamp.WithLabelValues(jobID).Set(successTimestamp)
+	}
+}
+
+// SetActiveJobs - Met à jour la jauge du nombre de jobs actifs
+func (m *Metrics) SetActiveJobs(n int) {
+	m.jobsActive.Set(float64(n))
+}
+
+// SetPausedJobs - Met à jour la jauge du nombre de jobs en pause
+func (m *Metrics) SetPausedJobs(n int) {
+	m.jobsPaused.Set(float64(n))
+}
+
+// Handler - Handler HTTP exposant les métriques au format Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
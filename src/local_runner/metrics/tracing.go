@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// TelemetryConfig - Paramétrage OpenTelemetry du runner
+type TelemetryConfig struct {
+	// OTLPEndpoint - Collecteur OTLP cible, vide désactive le traçage (tracer no-op)
+	OTLPEndpoint string `json:"otlp_endpoint"`
+	// SampleRate - Fraction des traces échantillonnées, entre 0 et 1
+	SampleRate float64 `json:"sample_rate"`
+}
+
+// NewTracerProvider - Construit le tracer provider configuré, ou un tracer no-op si aucun endpoint n'est fourni
+func NewTracerProvider(config TelemetryConfig) (oteltrace.TracerProvider, func(context.Context) error, error) {
+	if config.OTLPEndpoint == "" {
+		return otel.GetTracerProvider(), func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(context.Background(), otlptracegrpc.WithEndpoint(config.OTLPEndpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, nil, fmt.Errorf("erreur création exporteur OTLP: %w", err)
+	}
+
+	provider := trace.NewTracerProvider(
+		trace.WithBatcher(exporter),
+		trace.WithSampler(trace.TraceIDRatioBased(config.SampleRate)),
+	)
+	return provider, provider.Shutdown, nil
+}
+
+// JobSpanAttributes - Construit les attributs de span standard pour une exécution de job
+func JobSpanAttributes(jobID, command string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("datamine.job_id", jobID),
+		attribute.String("datamine.command", command),
+	}
+}
@@ -0,0 +1,66 @@
+// Package main - Store de jobs DATA-MINER
+// Remplace les dumps JSON horodatés ad-hoc par un historique de runs interrogeable
+package main
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrRunNotFound - Run introuvable dans le store
+var ErrRunNotFound = errors.New("run introuvable")
+
+// Run - Enregistrement structuré d'une exécution de job
+type Run struct {
+	JobID      string        `json:"job_id"`
+	RunID      string        `json:"run_id"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+	Duration   time.Duration `json:"duration"`
+	ExitCode   int           `json:"exit_code"`
+	Status     string        `json:"status"`
+	StdoutPath string        `json:"stdout_path"`
+	StderrPath string        `json:"stderr_path"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// JobState - Instantané persisté de l'état de pause d'un job, distinct des runs individuels
+// Sans cette persistance, un redémarrage du runner oublie qu'un job était en pause automatique et le relance
+// avec une fenêtre d'échecs vierge, annulant l'effet du backoff (voir recordOutcomeAndMaybePause)
+type JobState struct {
+	JobID       string    `json:"job_id"`
+	Status      string    `json:"status"`
+	PauseReason string    `json:"pause_reason,omitempty"`
+	PausedUntil time.Time `json:"paused_until"`
+}
+
+// JobStore - Persistance de l'historique des runs et accès aux logs associés
+type JobStore interface {
+	// SaveRun enregistre (ou met à jour) un run
+	SaveRun(run *Run) error
+	// ListRuns retourne les runs d'un job, du plus récent au plus ancien, limités à limit (0 = pas de limite)
+	ListRuns(jobID string, limit int) ([]*Run, error)
+	// GetRun retourne un run par son identifiant
+	GetRun(runID string) (*Run, error)
+	// TailLog retourne le contenu du stdout du run à partir de l'offset donné, et le nouvel offset
+	TailLog(runID string, offset int64) ([]byte, int64, error)
+	// SaveJobState enregistre (ou met à jour) l'état de pause d'un job
+	SaveJobState(state *JobState) error
+	// LoadJobStates retourne les états de pause persistés, indexés par job ID
+	LoadJobStates() (map[string]*JobState, error)
+	// Close libère les ressources sous-jacentes du store
+	Close() error
+}
+
+// NewJobStore - Construit le store configuré, avec repli automatique sur le store fichier
+func NewJobStore(config *GoRunnerConfig) (JobStore, error) {
+	if config.StoreDriver == "sqlite" || config.StoreDriver == "" {
+		store, err := NewSQLiteJobStore(config.LogPath)
+		if err == nil {
+			return store, nil
+		}
+		// Repli sur le store fichier si SQLite indisponible (ex: cgo désactivé)
+		return NewFileJobStore(config.LogPath)
+	}
+	return NewFileJobStore(config.LogPath)
+}
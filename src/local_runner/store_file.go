@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// FileJobStore - Repli sur disque du JobStore quand SQLite n'est pas disponible (ex: build sans cgo)
+// Un fichier JSON par run sous <LogPath>/runs/<job_id>/<run_id>.json, et un fichier JSON par état de job sous
+// <LogPath>/job_state/<job_id>.json
+type FileJobStore struct {
+	logRoot   string
+	stateRoot string
+}
+
+// NewFileJobStore - Construit le store fichier
+func NewFileJobStore(logPath string) (*FileJobStore, error) {
+	root := filepath.Join(logPath, "runs")
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("erreur création répertoire runs: %w", err)
+	}
+	stateRoot := filepath.Join(logPath, "job_state")
+	if err := os.MkdirAll(stateRoot, 0755); err != nil {
+		return nil, fmt.Errorf("erreur création répertoire job_state: %w", err)
+	}
+	return &FileJobStore{logRoot: root, stateRoot: stateRoot}, nil
+}
+
+func (s *FileJobStore) runDir(jobID string) string {
+	return filepath.Join(s.logRoot, jobID)
+}
+
+func (s *FileJobStore) runMetaPath(jobID, runID string) string {
+	return filepath.Join(s.runDir(jobID), runID+".json")
+}
+
+// SaveRun - Écrit le run au format JSON
+func (s *FileJobStore) SaveRun(run *Run) error {
+	if err := os.MkdirAll(s.runDir(run.JobID), 0755); err != nil {
+		return fmt.Errorf("erreur création répertoire job %s: %w", run.JobID, err)
+	}
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return fmt.Errorf("erreur sérialisation run %s: %w", run.RunID, err)
+	}
+	return os.WriteFile(s.runMetaPath(run.JobID, run.RunID), data, 0644)
+}
+
+// ListRuns - Parcourt les fichiers JSON du job, triés par date de début décroissante
+func (s *FileJobStore) ListRuns(jobID string, limit int) ([]*Run, error) {
+	entries, err := os.ReadDir(s.runDir(jobID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur listage runs job %s: %w", jobID, err)
+	}
+
+	var runs []*Run
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.runDir(jobID), entry.Name()))
+		if err != nil {
+			continue
+		}
+		var run Run
+		if err := json.Unmarshal(data, &run); err != nil {
+			continue
+		}
+		runs = append(runs, &run)
+	}
+
+	sort.Slice(runs, func(i, j int) bool { return runs[i].StartedAt.After(runs[j].StartedAt) })
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+// GetRun - Recherche le run dans tous les répertoires job (le run_id est unique)
+func (s *FileJobStore) GetRun(runID string) (*Run, error) {
+	jobDirs, err := os.ReadDir(s.logRoot)
+	if err != nil {
+		return nil, fmt.Errorf("erreur listage jobs: %w", err)
+	}
+
+	for _, jobDir := range jobDirs {
+		if !jobDir.IsDir() {
+			continue
+		}
+		path := s.runMetaPath(jobDir.Name(), runID)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var run Run
+		if err := json.Unmarshal(data, &run); err != nil {
+			return nil, fmt.Errorf("erreur désérialisation run %s: %w", runID, err)
+		}
+		return &run, nil
+	}
+	return nil, ErrRunNotFound
+}
+
+// TailLog - Lit le stdout du run depuis offset, retourne les octets lus et le nouvel offset
+func (s *FileJobStore) TailLog(runID string, offset int64) ([]byte, int64, error) {
+	run, err := s.GetRun(runID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tailLogFile(run.StdoutPath, offset)
+}
+
+// jobStatePath - Chemin du fichier JSON d'état pour un job donné
+func (s *FileJobStore) jobStatePath(jobID string) string {
+	return filepath.Join(s.stateRoot, jobID+".json")
+}
+
+// SaveJobState - Écrit l'état de pause du job au format JSON
+func (s *FileJobStore) SaveJobState(state *JobState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("erreur sérialisation état job %s: %w", state.JobID, err)
+	}
+	return os.WriteFile(s.jobStatePath(state.JobID), data, 0644)
+}
+
+// LoadJobStates - Parcourt les fichiers JSON d'état et les indexe par job ID
+func (s *FileJobStore) LoadJobStates() (map[string]*JobState, error) {
+	entries, err := os.ReadDir(s.stateRoot)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur listage états jobs: %w", err)
+	}
+
+	states := make(map[string]*JobState)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.stateRoot, entry.Name()))
+		if err != nil {
+			continue
+		}
+		var state JobState
+		if err := json.Unmarshal(data, &state); err != nil {
+			continue
+		}
+		states[state.JobID] = &state
+	}
+	return states, nil
+}
+
+// Close - Rien à libérer pour le store fichier
+func (s *FileJobStore) Close() error {
+	return nil
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFileJobStoreSaveAndGetRun(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	run := &Run{JobID: "job1", RunID: "run1", StartedAt: time.Now(), Status: "completed"}
+	if err := store.SaveRun(run); err != nil {
+		t.Fatalf("SaveRun() erreur: %v", err)
+	}
+
+	got, err := store.GetRun("run1")
+	if err != nil {
+		t.Fatalf("GetRun() erreur: %v", err)
+	}
+	if got.JobID != "job1" || got.Status != "completed" {
+		t.Errorf("GetRun() = %+v, attendu JobID=job1 Status=completed", got)
+	}
+}
+
+func TestFileJobStoreGetRunNotFound(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	if _, err := store.GetRun("missing"); err != ErrRunNotFound {
+		t.Fatalf("GetRun() erreur = %v, attendu ErrRunNotFound", err)
+	}
+}
+
+func TestFileJobStoreListRunsOrderedMostRecentFirst(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	now := time.Now()
+	runs := []*Run{
+		{JobID: "job1", RunID: "oldest", StartedAt: now.Add(-2 * time.Hour)},
+		{JobID: "job1", RunID: "newest", StartedAt: now},
+		{JobID: "job1", RunID: "middle", StartedAt: now.Add(-1 * time.Hour)},
+	}
+	for _, run := range runs {
+		if err := store.SaveRun(run); err != nil {
+			t.Fatalf("SaveRun() erreur: %v", err)
+		}
+	}
+
+	got, err := store.ListRuns("job1", 0)
+	if err != nil {
+		t.Fatalf("ListRuns() erreur: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("ListRuns() = %d runs, attendu 3", len(got))
+	}
+	wantOrder := []string{"newest", "middle", "oldest"}
+	for i, run := range got {
+		if run.RunID != wantOrder[i] {
+			t.Errorf("ListRuns()[%d] = %s, attendu %s", i, run.RunID, wantOrder[i])
+		}
+	}
+}
+
+func TestFileJobStoreListRunsRespectsLimit(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		run := &Run{JobID: "job1", RunID: "run" + string(rune('a'+i)), StartedAt: now.Add(time.Duration(i) * time.Minute)}
+		if err := store.SaveRun(run); err != nil {
+			t.Fatalf("SaveRun() erreur: %v", err)
+		}
+	}
+
+	got, err := store.ListRuns("job1", 2)
+	if err != nil {
+		t.Fatalf("ListRuns() erreur: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListRuns() = %d runs, attendu 2 (limite)", len(got))
+	}
+}
+
+func TestFileJobStoreListRunsUnknownJobReturnsEmpty(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	got, err := store.ListRuns("no-such-job", 0)
+	if err != nil {
+		t.Fatalf("ListRuns() erreur: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ListRuns() = %d runs, attendu 0 pour un job inconnu", len(got))
+	}
+}
+
+func TestFileJobStoreSaveAndLoadJobState(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	pausedUntil := time.Now().Add(time.Hour).Truncate(time.Second)
+	state := &JobState{JobID: "job1", Status: "paused", PauseReason: "5 échecs consécutifs", PausedUntil: pausedUntil}
+	if err := store.SaveJobState(state); err != nil {
+		t.Fatalf("SaveJobState() erreur: %v", err)
+	}
+
+	states, err := store.LoadJobStates()
+	if err != nil {
+		t.Fatalf("LoadJobStates() erreur: %v", err)
+	}
+	got, ok := states["job1"]
+	if !ok {
+		t.Fatalf("LoadJobStates() ne contient pas job1: %+v", states)
+	}
+	if got.Status != "paused" || got.PauseReason != "5 échecs consécutifs" || !got.PausedUntil.Equal(pausedUntil) {
+		t.Errorf("LoadJobStates()[job1] = %+v, attendu %+v", got, state)
+	}
+}
+
+func TestFileJobStoreLoadJobStatesEmptyWhenNoneSaved(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore() erreur: %v", err)
+	}
+
+	states, err := store.LoadJobStates()
+	if err != nil {
+		t.Fatalf("LoadJobStates() erreur: %v", err)
+	}
+	if len(states) != 0 {
+		t.Errorf("LoadJobStates() = %d états, attendu 0", len(states))
+	}
+}
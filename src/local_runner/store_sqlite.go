@@ -0,0 +1,204 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLiteJobStore - JobStore par défaut, persisté dans runner.db sous LogPath
+type SQLiteJobStore struct {
+	db      *sql.DB
+	logRoot string
+}
+
+// NewSQLiteJobStore - Ouvre (ou crée) la base SQLite du runner
+func NewSQLiteJobStore(logPath string) (*SQLiteJobStore, error) {
+	if err := os.MkdirAll(filepath.Join(logPath, "runs"), 0755); err != nil {
+		return nil, fmt.Errorf("erreur création répertoire runs: %w", err)
+	}
+
+	dbPath := filepath.Join(logPath, "runner.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("erreur ouverture sqlite: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("erreur connexion sqlite: %w", err)
+	}
+
+	const schema = `
+	CREATE TABLE IF NOT EXISTS runs (
+		run_id      TEXT PRIMARY KEY,
+		job_id      TEXT NOT NULL,
+		started_at  TEXT NOT NULL,
+		finished_at TEXT,
+		duration_ns INTEGER,
+		exit_code   INTEGER,
+		status      TEXT,
+		stdout_path TEXT,
+		stderr_path TEXT,
+		error       TEXT
+	);
+	CREATE INDEX IF NOT EXISTS idx_runs_job_id ON runs(job_id, started_at DESC);
+	CREATE TABLE IF NOT EXISTS job_state (
+		job_id       TEXT PRIMARY KEY,
+		status       TEXT NOT NULL,
+		pause_reason TEXT,
+		paused_until TEXT
+	);
+	`
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("erreur création schéma: %w", err)
+	}
+
+	return &SQLiteJobStore{db: db, logRoot: filepath.Join(logPath, "runs")}, nil
+}
+
+// SaveRun - Upsert d'un run dans la base
+func (s *SQLiteJobStore) SaveRun(run *Run) error {
+	_, err := s.db.Exec(`
+		INSERT INTO runs (run_id, job_id, started_at, finished_at, duration_ns, exit_code, status, stdout_path, stderr_path, error)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(run_id) DO UPDATE SET
+			finished_at = excluded.finished_at,
+			duration_ns = excluded.duration_ns,
+			exit_code   = excluded.exit_code,
+			status      = excluded.status,
+			error       = excluded.error
+	`,
+		run.RunID, run.JobID, run.StartedAt.Format(time.RFC3339Nano), run.FinishedAt.Format(time.RFC3339Nano),
+		run.Duration.Nanoseconds(), run.ExitCode, run.Status, run.StdoutPath, run.StderrPath, run.Error,
+	)
+	if err != nil {
+		return fmt.Errorf("erreur sauvegarde run %s: %w", run.RunID, err)
+	}
+	return nil
+}
+
+// ListRuns - Liste les runs d'un job, les plus récents en premier
+func (s *SQLiteJobStore) ListRuns(jobID string, limit int) ([]*Run, error) {
+	query := `SELECT run_id, job_id, started_at, finished_at, duration_ns, exit_code, status, stdout_path, stderr_path, error
+		FROM runs WHERE job_id = ? ORDER BY started_at DESC`
+	args := []interface{}{jobID}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erreur listage runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*Run
+	for rows.Next() {
+		run, err := scanRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// GetRun - Récupère un run par son identifiant
+func (s *SQLiteJobStore) GetRun(runID string) (*Run, error) {
+	row := s.db.QueryRow(`SELECT run_id, job_id, started_at, finished_at, duration_ns, exit_code, status, stdout_path, stderr_path, error
+		FROM runs WHERE run_id = ?`, runID)
+
+	run, err := scanRun(row)
+	if err == sql.ErrNoRows {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture run %s: %w", runID, err)
+	}
+	return run, nil
+}
+
+// TailLog - Lit le stdout du run depuis offset, retourne les octets lus et le nouvel offset
+func (s *SQLiteJobStore) TailLog(runID string, offset int64) ([]byte, int64, error) {
+	run, err := s.GetRun(runID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tailLogFile(run.StdoutPath, offset)
+}
+
+// SaveJobState - Upsert de l'état de pause d'un job dans la base
+func (s *SQLiteJobStore) SaveJobState(state *JobState) error {
+	_, err := s.db.Exec(`
+		INSERT INTO job_state (job_id, status, pause_reason, paused_until)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(job_id) DO UPDATE SET
+			status       = excluded.status,
+			pause_reason = excluded.pause_reason,
+			paused_until = excluded.paused_until
+	`,
+		state.JobID, state.Status, state.PauseReason, state.PausedUntil.Format(time.RFC3339Nano),
+	)
+	if err != nil {
+		return fmt.Errorf("erreur sauvegarde état job %s: %w", state.JobID, err)
+	}
+	return nil
+}
+
+// LoadJobStates - Charge les états de pause persistés, indexés par job ID
+func (s *SQLiteJobStore) LoadJobStates() (map[string]*JobState, error) {
+	rows, err := s.db.Query(`SELECT job_id, status, pause_reason, paused_until FROM job_state`)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement états jobs: %w", err)
+	}
+	defer rows.Close()
+
+	states := make(map[string]*JobState)
+	for rows.Next() {
+		var state JobState
+		var pausedUntil string
+		var pauseReason sql.NullString
+		if err := rows.Scan(&state.JobID, &state.Status, &pauseReason, &pausedUntil); err != nil {
+			return nil, fmt.Errorf("erreur lecture état job: %w", err)
+		}
+		state.PauseReason = pauseReason.String
+		state.PausedUntil, _ = time.Parse(time.RFC3339Nano, pausedUntil)
+		states[state.JobID] = &state
+	}
+	return states, rows.Err()
+}
+
+// Close - Ferme la connexion à la base
+func (s *SQLiteJobStore) Close() error {
+	return s.db.Close()
+}
+
+// rowScanner - Abstraction commune à sql.Row et sql.Rows pour scanRun
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRun(scanner rowScanner) (*Run, error) {
+	var run Run
+	var startedAt, finishedAt string
+	var durationNs int64
+	var errStr sql.NullString
+
+	err := scanner.Scan(&run.RunID, &run.JobID, &startedAt, &finishedAt, &durationNs,
+		&run.ExitCode, &run.Status, &run.StdoutPath, &run.StderrPath, &errStr)
+	if err != nil {
+		return nil, err
+	}
+
+	run.StartedAt, _ = time.Parse(time.RFC3339Nano, startedAt)
+	run.FinishedAt, _ = time.Parse(time.RFC3339Nano, finishedAt)
+	run.Duration = time.Duration(durationNs)
+	run.Error = errStr.String
+	return &run, nil
+}